@@ -1,10 +1,14 @@
 package lexer
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"github.com/owlci/gosonett/token"
+	"io"
+	"strings"
 	"unicode"
+	"unicode/utf16"
 )
 
 type LexerPosition struct {
@@ -22,67 +26,81 @@ func (lp *LexerPosition) NextChar() {
 }
 
 func (lp *LexerPosition) Format() string {
-	return fmt.Sprintf("%w:%w", lp.line, lp.lineChar)
+	return fmt.Sprintf("%d:%d", lp.line, lp.lineChar)
+}
+
+// LexerError is a malformed-input diagnostic tied to the source position
+// where it was found, returned alongside the tokens lexed up to that point
+// rather than aborting the whole scan.
+type LexerError struct {
+	Position LexerPosition
+	Message  string
+}
+
+func (e *LexerError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Position.Format(), e.Message)
 }
 
 type Lexer struct {
-	Source       string
-	Tokens       []token.Token
-	Position     LexerPosition // represents position of char with new lines, good for debugging.
-	index        int           // hold the current index of currentChar within the whole input string
-	sourceLength int
-	reachedEnd   bool
+	Position LexerPosition // represents position of char with new lines, good for debugging.
+
+	reader  *bufio.Reader
+	ring    []rune      // buffered lookahead runes, ring[0] is CurrentChar
+	lastErr *LexerError // set by recordIllegal for the call that produced it, consumed by Lex
 }
 
 const EOF = '\x00'
 
-func New(source string) *Lexer {
-	return &Lexer{
-		Source:       source,
-		Position:     LexerPosition{line: 0, lineChar: 0},
-		index:        0,
-		sourceLength: len(source),
-		reachedEnd:   false,
-	}
-}
+// lookahead is the number of runes the ring holds: CurrentChar plus the two
+// runes beyond it that NextToken's lookahead ever needs (the ":::" fence and
+// the "|||" text-block fence both peek two runes ahead).
+const lookahead = 3
 
-func (l *Lexer) willOverflow() bool {
-	return l.index+1 >= l.sourceLength
+func New(source string) *Lexer {
+	return NewReader(strings.NewReader(source))
 }
 
-// NOTE: This might need to rune, depending on what character set jsonet supports.
-func (l *Lexer) CurrentChar() rune {
-	if l.reachedEnd {
-		return EOF
+// NewReader builds a Lexer that pulls its runes from r on demand via a
+// small buffered ring, rather than requiring the whole source up front.
+func NewReader(r io.Reader) *Lexer {
+	l := &Lexer{
+		Position: LexerPosition{line: 0, lineChar: 0},
+		reader:   bufio.NewReader(r),
 	}
 
-	return rune(l.Source[l.index])
+	l.fill()
+
+	return l
 }
 
-// The first time we reach the end we expect the calling code to handle it correctly, either
-// by printing an error message for invalid source or by terminating the token. With this, we
-// are explicitly including EOF to be a valid lexeme in the token string.
-func (l *Lexer) invalidOverflow() bool {
-	if l.willOverflow() {
-		// We haven't reached the end yet
-		if l.reachedEnd == false {
-			l.reachedEnd = true
-			return false
+// fill tops the ring up to lookahead runes, stopping silently once the
+// reader is exhausted; running dry isn't an error here, it just means
+// charAt will start returning EOF.
+func (l *Lexer) fill() {
+	for len(l.ring) < lookahead {
+		r, _, err := l.reader.ReadRune()
+
+		if err != nil {
+			return
 		}
 
-		return true
+		l.ring = append(l.ring, r)
 	}
+}
 
-	return false
+func (l *Lexer) CurrentChar() rune {
+	return l.charAt(0)
 }
 
 func (l *Lexer) NextChar() (rune, error) {
-	if l.invalidOverflow() {
+	l.fill()
+
+	if len(l.ring) == 0 {
 		return EOF, errors.New("Unhandled end of input looking for the next character")
 	}
 
-	char := l.CurrentChar()
-	l.index++
+	char := l.ring[0]
+	l.ring = l.ring[1:]
 
 	if char == '\n' {
 		l.Position.NextLine()
@@ -95,23 +113,71 @@ func (l *Lexer) NextChar() (rune, error) {
 
 // Returns the next lookahead character without advancing the lexer
 func (l *Lexer) Peek() (rune, error) {
-	if l.invalidOverflow() {
-		return EOF, errors.New("Unhandled end of input peeking the next character")
+	return l.charAt(1), nil
+}
+
+// charAt returns the rune offset runes ahead of CurrentChar, or EOF if that
+// runs past the end of input. Used by compound-operator and
+// multi-character-fence lookahead, which need to see further ahead than
+// Peek's single character.
+func (l *Lexer) charAt(offset int) rune {
+	l.fill()
+
+	if offset >= len(l.ring) {
+		return EOF
 	}
 
-	return rune(l.Source[l.index+1]), nil
+	return l.ring[offset]
+}
+
+// newError builds a LexerError positioned at the lexer's current location.
+func (l *Lexer) newError(msg string) *LexerError {
+	return &LexerError{Position: l.Position, Message: msg}
 }
 
-// Advances through the whole string source and tokenizes every lexeme
-func (l *Lexer) Lex() []token.Token {
-	for r := l.Tokenize(); r.Type != token.EOF; r = l.Tokenize() {
+// recordIllegal stashes err (when it carries a source position) in lastErr
+// for the next call to Lex to pick up, and returns a synthetic ILLEGAL
+// token describing it, so malformed input surfaces as an ordinary token
+// rather than panicking.
+func (l *Lexer) recordIllegal(err error) token.Token {
+	if lexErr, ok := err.(*LexerError); ok {
+		l.lastErr = lexErr
 	}
 
-	return l.Tokens
+	return token.New(token.ILLEGAL, err.Error())
 }
 
-// Returns the next valid token in the input stream
-func (l *Lexer) Tokenize() token.Token {
+// Lex drains NextToken over the whole input, returning every token
+// alongside any malformed-input errors encountered. Unlike NextToken, which
+// streams one token at a time without retaining history, Lex collects both
+// slices itself, so only callers who actually want the whole input in
+// memory pay for it.
+func (l *Lexer) Lex() ([]token.Token, []LexerError) {
+	var tokens []token.Token
+	var errs []LexerError
+
+	for {
+		tok := l.NextToken()
+
+		if l.lastErr != nil {
+			errs = append(errs, *l.lastErr)
+			l.lastErr = nil
+		}
+
+		tokens = append(tokens, tok)
+
+		if tok.Type == token.EOF {
+			break
+		}
+	}
+
+	return tokens, errs
+}
+
+// NextToken scans and returns the next token from the input stream, one
+// token per call, terminating with a token.EOF token once the input is
+// exhausted.
+func (l *Lexer) NextToken() token.Token {
 	var tok token.Token
 
 	l.eatWhitespace()
@@ -140,11 +206,25 @@ func (l *Lexer) Tokenize() token.Token {
 	case ';':
 		tok = token.New(token.SEMICOLON, str)
 	case '!':
-		tok = token.New(token.BANG, str)
+		if l.charAt(1) == '=' {
+			l.NextChar()
+			tok = token.New(token.NEQ, "!=")
+		} else {
+			tok = token.New(token.BANG, str)
+		}
 	case '$':
 		tok = token.New(token.DOLLAR, str)
 	case ':':
-		tok = token.New(token.COLON, str)
+		if l.charAt(1) == ':' && l.charAt(2) == ':' {
+			l.NextChar()
+			l.NextChar()
+			tok = token.New(token.TRIPLECOLON, ":::")
+		} else if l.charAt(1) == ':' {
+			l.NextChar()
+			tok = token.New(token.DCOLON, "::")
+		} else {
+			tok = token.New(token.COLON, str)
+		}
 	case '~':
 		tok = token.New(token.TILDE, str)
 	case '+':
@@ -152,37 +232,62 @@ func (l *Lexer) Tokenize() token.Token {
 	case '-':
 		tok = token.New(token.MINUS, str)
 	case '&':
-		tok = token.New(token.AMP, str)
-	case '|':
-		tok = token.New(token.PIPE, str)
+		if l.charAt(1) == '&' {
+			l.NextChar()
+			tok = token.New(token.AND, "&&")
+		} else {
+			tok = token.New(token.AMP, str)
+		}
 	case '^':
 		tok = token.New(token.CARET, str)
 	case '=':
-		tok = token.New(token.ASSIGN, str)
+		if l.charAt(1) == '=' {
+			l.NextChar()
+			tok = token.New(token.EQ, "==")
+		} else {
+			tok = token.New(token.ASSIGN, str)
+		}
 	case '<':
-		tok = token.New(token.LANGLE, str)
+		switch l.charAt(1) {
+		case '=':
+			l.NextChar()
+			tok = token.New(token.LTE, "<=")
+		case '<':
+			l.NextChar()
+			tok = token.New(token.LSHIFT, "<<")
+		default:
+			tok = token.New(token.LANGLE, str)
+		}
 	case '>':
-		tok = token.New(token.RANGLE, str)
+		switch l.charAt(1) {
+		case '=':
+			l.NextChar()
+			tok = token.New(token.GTE, ">=")
+		case '>':
+			l.NextChar()
+			tok = token.New(token.RSHIFT, ">>")
+		default:
+			tok = token.New(token.RANGLE, str)
+		}
 	case '*':
 		tok = token.New(token.STAR, str)
 	case '/':
-		peekedChar, err := l.Peek()
-
-		if err != nil {
-			panic(err)
-		}
+		peekedChar, _ := l.Peek()
 
 		// Single-line comment
 		if peekedChar == '/' {
 			l.eatCurrentLine()
-			return l.Tokenize()
+			return l.NextToken()
 		}
 
 		// Multi-line comment
 		if peekedChar == '*' {
-			// TODO: Handle multi-line-comments
-			// l.eatMultiLineComment()
-			// return l.Tokenize()
+			if err := l.eatMultiLineComment(); err != nil {
+				tok = l.recordIllegal(err)
+				break
+			}
+
+			return l.NextToken()
 		}
 
 		// Must be a single token acting as an operator
@@ -191,27 +296,84 @@ func (l *Lexer) Tokenize() token.Token {
 		tok = token.New(token.PERC, str)
 	case '#':
 		l.eatCurrentLine()
-		return l.Tokenize()
+		return l.NextToken()
+	case '|':
+		// A "|||" fence opens a Jsonnet text block rather than the PIPE operator.
+		if l.charAt(1) == '|' && l.charAt(2) == '|' {
+			value, err := l.lexTextBlock()
+
+			if err != nil {
+				tok = l.recordIllegal(err)
+			} else {
+				tok = token.New(token.STRING, value)
+			}
+
+			// lexTextBlock already consumes its own closing fence and
+			// newline, so skip the generic trailing NextChar below.
+			return tok
+		}
+
+		if l.charAt(1) == '|' {
+			l.NextChar()
+			tok = token.New(token.OR, "||")
+			break
+		}
+
+		tok = token.New(token.PIPE, str)
+	case '@':
+		peekedChar, _ := l.Peek()
+
+		if peekedChar != '"' && peekedChar != '\'' {
+			tok = l.recordIllegal(l.newError("expected a quote after '@'"))
+			break
+		}
+
+		l.NextChar() // move onto the opening quote
+		quote := l.CurrentChar()
+		l.NextChar() // move past the opening quote
+
+		value, err := l.eatVerbatimString(quote)
+
+		if err != nil {
+			tok = l.recordIllegal(err)
+			break
+		}
+
+		tok = token.New(token.STRING, value)
 	case '"', '\'':
 		// Whatever the opening char, we expect a closing char to match
 		// but skip the first occurance since it starts the string
 		l.NextChar()
-		stringValue := l.eatUntil(char)
+		stringValue, err := l.lexString(char)
+
+		if err != nil {
+			tok = l.recordIllegal(err)
+			break
+		}
+
 		tok = token.New(token.STRING, stringValue)
-	// case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
-	// token, _ := l.lexNumber()
+	case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		tok, err := l.lexNumber()
+
+		if err != nil {
+			tok = l.recordIllegal(err)
+		}
+
+		// lexNumber already leaves CurrentChar on the character after the
+		// literal, so skip the generic trailing NextChar below.
+		return tok
 	default:
 		if isIdentifierFirst(char) {
-			// NOTE: Error handling
-			tok, _ = l.lexIdentifier()
-		} else {
-			// TODO: Use the LexerPosition struct to print out something nice here
-			panic("Unknown lexing character")
+			tok, _ := l.lexIdentifier()
+
+			// lexIdentifier already leaves CurrentChar on the character
+			// after the identifier/keyword, so skip the generic trailing
+			// NextChar below.
+			return tok
 		}
-	}
 
-	// Store the token
-	l.Tokens = append(l.Tokens, tok)
+		tok = l.recordIllegal(l.newError(fmt.Sprintf("unexpected character %q", char)))
+	}
 
 	// End of token, advance to next byte
 	l.NextChar()
@@ -227,16 +389,15 @@ func (l *Lexer) eatWhitespace() {
 	}
 }
 
-// TODO: This should panic if it doesn't find *untilChar* and reaches EOF
+// eatUntil consumes characters up to (but not including) untilChar, or up
+// to EOF if untilChar never appears. It is only reached via eatUntilAfter
+// from eatCurrentLine, where a comment may legitimately run to the end of
+// the input, so running off the end is not an error.
 func (l *Lexer) eatUntil(untilChar rune) string {
 	var eatenStr string
 
-	for l.CurrentChar() != untilChar {
-		char, err := l.NextChar()
-
-		if err != nil {
-			panic(err)
-		}
+	for l.CurrentChar() != untilChar && l.CurrentChar() != EOF {
+		char, _ := l.NextChar()
 
 		eatenStr = eatenStr + string(char)
 	}
@@ -257,34 +418,416 @@ func (l *Lexer) eatCurrentLine() {
 	l.eatUntilAfter('\n')
 }
 
-// TODO...
-func (l *Lexer) eatMultiLineComment() {
+// lexString consumes a Jsonnet `"..."`/`'...'` string body, decoding
+// backslash escapes as it goes. CurrentChar must be the first character
+// after the opening quote; it stops (without consuming) on the matching
+// closing quote so NextToken's trailing NextChar can step past it.
+func (l *Lexer) lexString(quote rune) (string, error) {
+	var value strings.Builder
+
+	for {
+		char := l.CurrentChar()
+
+		if char == EOF {
+			return "", l.newError("unterminated string literal")
+		}
+
+		if char == quote {
+			return value.String(), nil
+		}
+
+		if char == '\\' {
+			decoded, err := l.decodeEscape()
+
+			if err != nil {
+				return "", err
+			}
+
+			value.WriteString(decoded)
+
+			continue
+		}
+
+		value.WriteRune(char)
+
+		if _, err := l.NextChar(); err != nil {
+			return "", l.newError("unterminated string literal")
+		}
+	}
 }
 
-func (l *Lexer) lexIdentifier() (token.Token, error) {
-	startIndex := l.index
+// decodeEscape consumes a backslash escape sequence (CurrentChar is the
+// '\\') per the Jsonnet spec, returning its decoded text. A `\uXXXX`
+// escape that opens a UTF-16 surrogate pair is combined with the `\uXXXX`
+// that follows into a single decoded rune.
+func (l *Lexer) decodeEscape() (string, error) {
+	l.NextChar() // consume '\\'
 
-	for isIdentifier(l.CurrentChar()) {
-		char, err := l.NextChar()
+	switch l.CurrentChar() {
+	case '"':
+		l.NextChar()
+		return "\"", nil
+	case '\'':
+		l.NextChar()
+		return "'", nil
+	case '\\':
+		l.NextChar()
+		return "\\", nil
+	case '/':
+		l.NextChar()
+		return "/", nil
+	case 'b':
+		l.NextChar()
+		return "\b", nil
+	case 'f':
+		l.NextChar()
+		return "\f", nil
+	case 'n':
+		l.NextChar()
+		return "\n", nil
+	case 'r':
+		l.NextChar()
+		return "\r", nil
+	case 't':
+		l.NextChar()
+		return "\t", nil
+	case 'u':
+		l.NextChar()
 
-		if err != nil {
-			panic(err)
+		return l.decodeUnicodeEscape()
+	case EOF:
+		return "", l.newError("unterminated escape sequence")
+	default:
+		return "", l.newError(fmt.Sprintf("invalid escape sequence '\\%c'", l.CurrentChar()))
+	}
+}
+
+// decodeUnicodeEscape reads the four hex digits of a `\uXXXX` escape
+// (CurrentChar is the first digit) and, if it forms the leading half of a
+// UTF-16 surrogate pair, consumes a following `\uXXXX` to complete it.
+func (l *Lexer) decodeUnicodeEscape() (string, error) {
+	first, err := l.lexHex4()
+
+	if err != nil {
+		return "", err
+	}
+
+	if !utf16.IsSurrogate(rune(first)) {
+		return string(rune(first)), nil
+	}
+
+	if l.CurrentChar() != '\\' || l.charAt(1) != 'u' {
+		return "", l.newError("unpaired UTF-16 surrogate in \\u escape")
+	}
+
+	l.NextChar() // consume '\\'
+	l.NextChar() // consume 'u'
+
+	second, err := l.lexHex4()
+
+	if err != nil {
+		return "", err
+	}
+
+	combined := utf16.DecodeRune(rune(first), rune(second))
+
+	if combined == unicode.ReplacementChar {
+		return "", l.newError("invalid UTF-16 surrogate pair in \\u escape")
+	}
+
+	return string(combined), nil
+}
+
+// lexHex4 reads exactly four hex digits starting at CurrentChar and
+// returns their value, advancing past them.
+func (l *Lexer) lexHex4() (uint16, error) {
+	var value uint16
+
+	for i := 0; i < 4; i++ {
+		digit, ok := hexDigitValue(l.CurrentChar())
+
+		if !ok {
+			return 0, l.newError("invalid \\u escape, expected 4 hex digits")
 		}
 
+		value = value<<4 | uint16(digit)
+
+		if _, err := l.NextChar(); err != nil {
+			return 0, l.newError("invalid \\u escape, expected 4 hex digits")
+		}
+	}
+
+	return value, nil
+}
+
+func hexDigitValue(r rune) (int, bool) {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0'), true
+	case r >= 'a' && r <= 'f':
+		return int(r-'a') + 10, true
+	case r >= 'A' && r <= 'F':
+		return int(r-'A') + 10, true
+	default:
+		return 0, false
+	}
+}
+
+// eatMultiLineComment skips a `/* ... */` comment, including any embedded
+// newlines. CurrentChar must be the opening `/` of the comment fence.
+func (l *Lexer) eatMultiLineComment() error {
+	l.NextChar() // consume the opening '/'
+	l.NextChar() // consume the opening '*'
+
+	for {
+		char := l.CurrentChar()
+
 		if char == EOF {
-			break
+			return l.newError("unterminated multi-line comment")
+		}
+
+		if char == '*' {
+			if peeked, err := l.Peek(); err == nil && peeked == '/' {
+				l.NextChar() // consume '*'
+				l.NextChar() // consume '/'
+
+				return nil
+			}
+		}
+
+		if _, err := l.NextChar(); err != nil {
+			return l.newError("unterminated multi-line comment")
+		}
+	}
+}
+
+// eatVerbatimString consumes a Jsonnet verbatim string body (`@"..."` or
+// `@'...'`), where the only recognised escape is a doubled quote. Unlike
+// eatUntilAfter, CurrentChar is left on the unconsumed closing quote so
+// NextToken's trailing NextChar can step past it like other string forms.
+func (l *Lexer) eatVerbatimString(quote rune) (string, error) {
+	var value strings.Builder
+
+	for {
+		char := l.CurrentChar()
+
+		if char == EOF {
+			return "", l.newError("unterminated verbatim string")
+		}
+
+		if char == quote {
+			if peeked, err := l.Peek(); err == nil && peeked == quote {
+				value.WriteRune(quote)
+				l.NextChar()
+				l.NextChar()
+
+				continue
+			}
+
+			return value.String(), nil
+		}
+
+		value.WriteRune(char)
+
+		if _, err := l.NextChar(); err != nil {
+			return "", l.newError("unterminated verbatim string")
+		}
+	}
+}
+
+// lexTextBlock consumes a Jsonnet `|||` ... `|||` text block. CurrentChar
+// is the first `|` of the opening fence. Per the Jsonnet reference, the
+// block runs until a line containing only whitespace followed by `|||`,
+// and the minimum common indentation of its content lines is stripped.
+func (l *Lexer) lexTextBlock() (string, error) {
+	l.NextChar() // consume the three '|' of the opening fence
+	l.NextChar()
+	l.NextChar()
+
+	// Only whitespace may follow the fence before the newline.
+	for l.CurrentChar() != '\n' {
+		if l.CurrentChar() == EOF {
+			return "", l.newError("unterminated text block")
+		}
+
+		if !unicode.IsSpace(l.CurrentChar()) {
+			return "", l.newError("text block content must start on the line after '|||'")
+		}
+
+		l.NextChar()
+	}
+
+	l.NextChar() // consume the newline
+
+	var rawLines []string
+	var line strings.Builder
+
+	for {
+		char := l.CurrentChar()
+
+		if char == EOF {
+			// A closing fence on the last line needs no trailing newline.
+			if strings.TrimSpace(line.String()) == "|||" {
+				break
+			}
+
+			return "", l.newError("unterminated text block")
+		}
+
+		if char == '\n' {
+			if strings.TrimSpace(line.String()) == "|||" {
+				l.NextChar() // consume the newline that closed the fence line
+
+				break
+			}
+
+			rawLines = append(rawLines, line.String())
+			line.Reset()
+			l.NextChar()
+
+			continue
+		}
+
+		line.WriteRune(char)
+
+		if _, err := l.NextChar(); err != nil {
+			return "", l.newError("unterminated text block")
+		}
+	}
+
+	indent := minIndent(rawLines)
+
+	for i, rawLine := range rawLines {
+		if len(rawLine) >= indent {
+			rawLines[i] = rawLine[indent:]
+		} else {
+			rawLines[i] = ""
+		}
+	}
+
+	return strings.Join(rawLines, "\n"), nil
+}
+
+// minIndent returns the length of the shortest leading-whitespace run
+// shared by every non-blank line, used to de-indent a Jsonnet text block.
+func minIndent(lines []string) int {
+	min := -1
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		count := 0
+
+		for _, r := range line {
+			if r != ' ' && r != '\t' {
+				break
+			}
+
+			count++
+		}
+
+		if min == -1 || count < min {
+			min = count
 		}
 	}
 
-	ident := l.Source[startIndex:l.index]
+	if min == -1 {
+		return 0
+	}
+
+	return min
+}
+
+func (l *Lexer) lexIdentifier() (token.Token, error) {
+	var ident strings.Builder
+
+	for isIdentifier(l.CurrentChar()) {
+		ident.WriteRune(l.CurrentChar())
+
+		if _, err := l.NextChar(); err != nil {
+			break
+		}
+	}
 
-	// Backtrack one char to end on the last byte of the identifier/keyword
-	l.index--
+	value := ident.String()
 
 	// matchKeyword and return keyword token
-	tokenType := token.GetKeywordKind(ident)
+	tokenType := token.GetKeywordKind(value)
+
+	return token.Token{Type: tokenType, Value: value}, nil
+}
+
+// lexNumber consumes a Jsonnet number literal starting at CurrentChar:
+// an integer part (`0` or `[1-9][0-9]*`), an optional fractional part
+// (`.[0-9]+`) and an optional exponent (`[eE][+-]?[0-9]+`). Malformed
+// forms (a leading zero followed by more digits, a dangling exponent)
+// are reported as a positioned ILLEGAL token rather than panicking.
+func (l *Lexer) lexNumber() (token.Token, error) {
+	var literal strings.Builder
+
+	if l.CurrentChar() == '0' {
+		literal.WriteRune('0')
+		l.NextChar()
+
+		if isDigit(l.CurrentChar()) {
+			return token.Token{}, l.newError("malformed number literal: leading zero followed by a digit")
+		}
+	} else {
+		for isDigit(l.CurrentChar()) {
+			literal.WriteRune(l.CurrentChar())
+
+			if _, err := l.NextChar(); err != nil {
+				break
+			}
+		}
+	}
+
+	if l.CurrentChar() == '.' {
+		literal.WriteRune('.')
+		l.NextChar()
+
+		if !isDigit(l.CurrentChar()) {
+			return token.Token{}, l.newError("malformed number literal: expected a digit after '.'")
+		}
+
+		for isDigit(l.CurrentChar()) {
+			literal.WriteRune(l.CurrentChar())
+
+			if _, err := l.NextChar(); err != nil {
+				break
+			}
+		}
+	}
+
+	if l.CurrentChar() == 'e' || l.CurrentChar() == 'E' {
+		literal.WriteRune(l.CurrentChar())
+		l.NextChar()
+
+		if l.CurrentChar() == '+' || l.CurrentChar() == '-' {
+			literal.WriteRune(l.CurrentChar())
+			l.NextChar()
+		}
+
+		if !isDigit(l.CurrentChar()) {
+			return token.Token{}, l.newError("malformed number literal: dangling exponent")
+		}
+
+		for isDigit(l.CurrentChar()) {
+			literal.WriteRune(l.CurrentChar())
+
+			if _, err := l.NextChar(); err != nil {
+				break
+			}
+		}
+	}
+
+	return token.New(token.NUMBER, literal.String()), nil
+}
 
-	return token.Token{Type: tokenType, Value: ident}, nil
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
 }
 
 // NOTE: Taken from here https://github.com/google/go-jsonnet/blob/master/lexer.go#L189
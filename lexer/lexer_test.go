@@ -2,6 +2,7 @@ package lexer
 
 import (
 	"github.com/owlci/gosonett/token"
+	"strings"
 	"testing"
 )
 
@@ -12,7 +13,7 @@ type TokenMatcher struct {
 
 func runTokenMatches(t *testing.T, source string, tests []TokenMatcher) {
 	lexer := New(source)
-	tokens := lexer.Lex()
+	tokens, _ := lexer.Lex()
 	testsLength := len(tests)
 	tokensLength := len(tokens)
 
@@ -176,6 +177,221 @@ func TestString(t *testing.T) {
 	runTokenMatches(t, source, tests)
 }
 
+func TestNumbers(t *testing.T) {
+	source := "0 3 123 0.5 3.14159 1e10 1E-5 2.5e+3"
+
+	tests := []TokenMatcher{
+		{token.NUMBER, "0"},
+		{token.NUMBER, "3"},
+		{token.NUMBER, "123"},
+		{token.NUMBER, "0.5"},
+		{token.NUMBER, "3.14159"},
+		{token.NUMBER, "1e10"},
+		{token.NUMBER, "1E-5"},
+		{token.NUMBER, "2.5e+3"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestMalformedNumbers(t *testing.T) {
+	sources := []string{"01", "1e", "1.", "1e+"}
+
+	for _, source := range sources {
+		lexer := New(source)
+		tokens, _ := lexer.Lex()
+
+		if len(tokens) == 0 || tokens[0].Type != token.ILLEGAL {
+			t.Fatalf("expected ILLEGAL token for %q, got %+v", source, tokens)
+		}
+	}
+}
+
+func TestMultiLineComments(t *testing.T) {
+	source := `
+! /* a
+multi
+line comment */ =
+`
+
+	tests := []TokenMatcher{
+		{token.BANG, "!"},
+		{token.ASSIGN, "="},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestMultiLineCommentWithSlashRightAfterOpeningStar(t *testing.T) {
+	source := "local x = 1; /*/ ===== divider ===== /*/ local y = 2;"
+
+	tests := []TokenMatcher{
+		{token.LOCAL, "local"},
+		{token.IDENT, "x"},
+		{token.ASSIGN, "="},
+		{token.NUMBER, "1"},
+		{token.SEMICOLON, ";"},
+		{token.LOCAL, "local"},
+		{token.IDENT, "y"},
+		{token.ASSIGN, "="},
+		{token.NUMBER, "2"},
+		{token.SEMICOLON, ";"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestUnterminatedMultiLineComment(t *testing.T) {
+	lexer := New("! /* unterminated")
+	tokens, _ := lexer.Lex()
+
+	if tokens[1].Type != token.ILLEGAL {
+		t.Fatalf("expected ILLEGAL token, got %+v", tokens[1])
+	}
+}
+
+func TestVerbatimStrings(t *testing.T) {
+	source := "@\"say \"\"hi\"\"\" @'it''s a test'\n"
+
+	tests := []TokenMatcher{
+		{token.STRING, `say "hi"`},
+		{token.STRING, "it's a test"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestTextBlock(t *testing.T) {
+	source := "|||\n  Hello\n    World\n|||\n"
+
+	tests := []TokenMatcher{
+		{token.STRING, "Hello\n  World"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestUnterminatedTextBlock(t *testing.T) {
+	lexer := New("|||\nHello\n")
+	tokens, _ := lexer.Lex()
+
+	if tokens[0].Type != token.ILLEGAL {
+		t.Fatalf("expected ILLEGAL token, got %+v", tokens[0])
+	}
+}
+
+func TestCompoundOperators(t *testing.T) {
+	source := "== != <= >= << >> && || :: :::"
+
+	tests := []TokenMatcher{
+		{token.EQ, "=="},
+		{token.NEQ, "!="},
+		{token.LTE, "<="},
+		{token.GTE, ">="},
+		{token.LSHIFT, "<<"},
+		{token.RSHIFT, ">>"},
+		{token.AND, "&&"},
+		{token.OR, "||"},
+		{token.DCOLON, "::"},
+		{token.TRIPLECOLON, ":::"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestAmbiguousOperators(t *testing.T) {
+	source := "!x != x a:::b"
+
+	tests := []TokenMatcher{
+		{token.BANG, "!"},
+		{token.IDENT, "x"},
+		{token.NEQ, "!="},
+		{token.IDENT, "x"},
+		{token.IDENT, "a"},
+		{token.TRIPLECOLON, ":::"},
+		{token.IDENT, "b"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestStringEscapes(t *testing.T) {
+	source := `"\n\t\"\\é😀"` + "\n"
+
+	tests := []TokenMatcher{
+		{token.STRING, "\n\t\"\\é\U0001F600"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestUnicodeSource(t *testing.T) {
+	source := `"héllo wörld" café` + "\n"
+
+	tests := []TokenMatcher{
+		{token.STRING, "héllo wörld"},
+		{token.IDENT, "café"},
+		{token.EOF, "(EOF)"},
+	}
+
+	runTokenMatches(t, source, tests)
+}
+
+func TestMalformedStringEscapes(t *testing.T) {
+	sources := []string{`"\q"`, `"\u12"`, `"\ud83d"`, `"unterminated`}
+
+	for _, source := range sources {
+		lexer := New(source)
+		tokens, _ := lexer.Lex()
+
+		if len(tokens) == 0 || tokens[0].Type != token.ILLEGAL {
+			t.Fatalf("expected ILLEGAL token for %q, got %+v", source, tokens)
+		}
+	}
+}
+
+func TestLexerErrorsCarryPosition(t *testing.T) {
+	lexer := New("x\n\"unterminated")
+	_, errs := lexer.Lex()
+
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one LexerError, got %d: %+v", len(errs), errs)
+	}
+
+	if errs[0].Position.line != 1 {
+		t.Fatalf("expected error on line 1, got %d", errs[0].Position.line)
+	}
+}
+
+func TestUnknownCharacter(t *testing.T) {
+	lexer := New("x ` y")
+	tokens, errs := lexer.Lex()
+
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one LexerError, got %d: %+v", len(errs), errs)
+	}
+
+	var sawIllegal bool
+
+	for _, tok := range tokens {
+		if tok.Type == token.ILLEGAL {
+			sawIllegal = true
+		}
+	}
+
+	if !sawIllegal {
+		t.Fatalf("expected an ILLEGAL token, got %+v", tokens)
+	}
+}
+
 func TestSnippet(t *testing.T) {
 	source := `
 // Jsonnet Example
@@ -225,3 +441,63 @@ func TestSnippet(t *testing.T) {
 
 	runTokenMatches(t, source, tests)
 }
+
+func TestNewReaderStreaming(t *testing.T) {
+	lexer := NewReader(strings.NewReader("a + b"))
+	tokens, errs := lexer.Lex()
+
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %+v", errs)
+	}
+
+	tests := []TokenMatcher{
+		{token.IDENT, "a"},
+		{token.PLUS, "+"},
+		{token.IDENT, "b"},
+		{token.EOF, "(EOF)"},
+	}
+
+	for i, tm := range tests {
+		if tokens[i].Type != tm.expectedType || tokens[i].Value != tm.expectedValue {
+			t.Fatalf("token %d: expected %+v, got %+v", i, tm, tokens[i])
+		}
+	}
+}
+
+func TestNextTokenOneAtATime(t *testing.T) {
+	lexer := New("!=")
+
+	first := lexer.NextToken()
+
+	if first.Type != token.NEQ || first.Value != "!=" {
+		t.Fatalf("expected NEQ token, got %+v", first)
+	}
+
+	second := lexer.NextToken()
+
+	if second.Type != token.EOF {
+		t.Fatalf("expected EOF token, got %+v", second)
+	}
+}
+
+// TestPeekAtEndOfInput guards against a past bug where Peek returned the
+// character after the lookahead character instead of the character after
+// CurrentChar, which overran the input and panicked on sources ending
+// exactly on the char being peeked past.
+func TestPeekAtEndOfInput(t *testing.T) {
+	lexer := New("a")
+
+	peeked, err := lexer.Peek()
+
+	if err != nil {
+		t.Fatalf("expected no error peeking past the last char, got %v", err)
+	}
+
+	if peeked != EOF {
+		t.Fatalf("expected EOF peeking past the last char, got %q", peeked)
+	}
+
+	if lexer.CurrentChar() != 'a' {
+		t.Fatalf("Peek must not advance the lexer, CurrentChar is now %q", lexer.CurrentChar())
+	}
+}
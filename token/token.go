@@ -0,0 +1,113 @@
+package token
+
+// TokenType identifies the lexical class of a Token.
+type TokenType string
+
+// Token is a single lexeme produced by the Lexer, tagged with its kind.
+type Token struct {
+	Type  TokenType
+	Value string
+}
+
+// New builds a Token of the given type carrying value as its literal text.
+func New(tokenType TokenType, value string) Token {
+	return Token{Type: tokenType, Value: value}
+}
+
+const (
+	EOF     TokenType = "EOF"
+	ILLEGAL TokenType = "ILLEGAL"
+
+	IDENT  TokenType = "IDENT"
+	STRING TokenType = "STRING"
+	NUMBER TokenType = "NUMBER"
+
+	// Symbols
+	LBRACE    TokenType = "LBRACE"
+	RBRACE    TokenType = "RBRACE"
+	LBRACKET  TokenType = "LBRACKET"
+	RBRACKET  TokenType = "RBRACKET"
+	LPAREN    TokenType = "LPAREN"
+	RPAREN    TokenType = "RPAREN"
+	COMMA     TokenType = "COMMA"
+	DOT       TokenType = "DOT"
+	SEMICOLON TokenType = "SEMICOLON"
+
+	// Operators
+	BANG   TokenType = "BANG"
+	DOLLAR TokenType = "DOLLAR"
+	COLON  TokenType = "COLON"
+	TILDE  TokenType = "TILDE"
+	PLUS   TokenType = "PLUS"
+	MINUS  TokenType = "MINUS"
+	AMP    TokenType = "AMP"
+	PIPE   TokenType = "PIPE"
+	CARET  TokenType = "CARET"
+	ASSIGN TokenType = "ASSIGN"
+	LANGLE TokenType = "LANGLE"
+	RANGLE TokenType = "RANGLE"
+	STAR   TokenType = "STAR"
+	SLASH  TokenType = "SLASH"
+	PERC   TokenType = "PERC"
+
+	// Compound operators
+	EQ          TokenType = "EQ"
+	NEQ         TokenType = "NEQ"
+	LTE         TokenType = "LTE"
+	GTE         TokenType = "GTE"
+	LSHIFT      TokenType = "LSHIFT"
+	RSHIFT      TokenType = "RSHIFT"
+	AND         TokenType = "AND"
+	OR          TokenType = "OR"
+	DCOLON      TokenType = "DCOLON"
+	TRIPLECOLON TokenType = "TRIPLECOLON"
+
+	// Keywords
+	ASSERT     TokenType = "ASSERT"
+	ERROR      TokenType = "ERROR"
+	IF         TokenType = "IF"
+	THEN       TokenType = "THEN"
+	ELSE       TokenType = "ELSE"
+	TRUE       TokenType = "TRUE"
+	FALSE      TokenType = "FALSE"
+	FOR        TokenType = "FOR"
+	FUNCTION   TokenType = "FUNCTION"
+	IMPORT     TokenType = "IMPORT"
+	IMPORTSTR  TokenType = "IMPORTSTR"
+	TAILSTRICT TokenType = "TAILSTRICT"
+	IN         TokenType = "IN"
+	LOCAL      TokenType = "LOCAL"
+	NULL       TokenType = "NULL"
+	SELF       TokenType = "SELF"
+	SUPER      TokenType = "SUPER"
+)
+
+var keywords = map[string]TokenType{
+	"assert":     ASSERT,
+	"error":      ERROR,
+	"if":         IF,
+	"then":       THEN,
+	"else":       ELSE,
+	"true":       TRUE,
+	"false":      FALSE,
+	"for":        FOR,
+	"function":   FUNCTION,
+	"import":     IMPORT,
+	"importstr":  IMPORTSTR,
+	"tailstrict": TAILSTRICT,
+	"in":         IN,
+	"local":      LOCAL,
+	"null":       NULL,
+	"self":       SELF,
+	"super":      SUPER,
+}
+
+// GetKeywordKind returns the keyword TokenType for ident, or IDENT if it
+// isn't a reserved word.
+func GetKeywordKind(ident string) TokenType {
+	if tokenType, ok := keywords[ident]; ok {
+		return tokenType
+	}
+
+	return IDENT
+}